@@ -134,6 +134,13 @@ func (st *Store) LastCommitID() types.CommitID {
 	}
 }
 
+// WorkingHash returns the root hash of this store's working tree, including any
+// changes not yet committed. It is not the app hash; that is the multistore
+// hash over every store, see rootmulti.Store.WorkingHash.
+func (st *Store) WorkingHash() []byte {
+	return st.tree.WorkingHash()
+}
+
 // Implements Committer.
 func (st *Store) SetPruning(opt types.PruningOptions) {
 	st.numRecent = opt.KeepRecent()
@@ -98,6 +98,25 @@ func TestTestGetImmutableIterator(t *testing.T) {
 	require.Equal(t, len(expected), i)
 }
 
+func TestWorkingHash(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, cID := newAlohaTree(t, db)
+	store := UnsafeNewStore(tree, 10, 10)
+	require.Equal(t, cID.Hash, store.WorkingHash())
+
+	store.Set([]byte("hello"), []byte("adios"))
+	hash := store.WorkingHash()
+	require.NotEqual(t, cID.Hash, hash)
+	require.Equal(t, cID, store.LastCommitID())
+
+	newID := store.Commit()
+	require.Equal(t, hash, newID.Hash)
+
+	newStore, err := store.GetImmutable(cID.Version)
+	require.NoError(t, err)
+	require.Equal(t, cID.Hash, newStore.WorkingHash())
+}
+
 func TestIAVLStoreGetSetHasDelete(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree, _ := newAlohaTree(t, db)
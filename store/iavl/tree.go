@@ -25,6 +25,7 @@ type (
 		DeleteVersion(version int64) error
 		Version() int64
 		Hash() []byte
+		WorkingHash() []byte
 		VersionExists(version int64) bool
 		GetVersioned(key []byte, version int64) (int64, []byte)
 		GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
@@ -55,6 +56,10 @@ func (it *immutableTree) DeleteVersion(_ int64) error {
 	panic("cannot call 'DeleteVersion' on an immutable IAVL tree")
 }
 
+func (it *immutableTree) WorkingHash() []byte {
+	return it.Hash()
+}
+
 func (it *immutableTree) VersionExists(version int64) bool {
 	return it.Version() == version
 }
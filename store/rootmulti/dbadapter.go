@@ -30,4 +30,8 @@ func (cdsa commitDBStoreAdapter) LastCommitID() types.CommitID {
 	}
 }
 
+func (cdsa commitDBStoreAdapter) WorkingHash() []byte {
+	return commithash
+}
+
 func (cdsa commitDBStoreAdapter) SetPruning(_ types.PruningOptions) {}
@@ -220,6 +220,37 @@ func (rs *Store) Commit() types.CommitID {
 	return commitID
 }
 
+// workingHasher is implemented by the commit stores that can hash their
+// uncommitted state.
+type workingHasher interface {
+	WorkingHash() []byte
+}
+
+// WorkingHash returns the app hash the next Commit would produce, built from
+// the working hash of each mounted store. Nothing is committed or written to
+// disk, so the hash is available before the block's writes are flushed.
+func (rs *Store) WorkingHash() []byte {
+	storeInfos := make([]storeInfo, 0, len(rs.stores))
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+
+		ws, ok := store.(workingHasher)
+		if !ok {
+			panic(fmt.Sprintf("store %s does not support WorkingHash", key.Name()))
+		}
+
+		si := storeInfo{}
+		si.Name = key.Name()
+		si.Core.CommitID = types.CommitID{Hash: ws.WorkingHash()}
+		storeInfos = append(storeInfos, si)
+	}
+
+	return commitInfo{StoreInfos: storeInfos}.Hash()
+}
+
 // Implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -96,6 +96,39 @@ func TestHashStableWithEmptyCommit(t *testing.T) {
 	require.Equal(t, hash, cID.Hash)
 }
 
+func TestWorkingHash(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db)
+	ms.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	store1 := ms.getStoreByName("store1").(types.KVStore)
+	store1.Set([]byte("wind"), []byte("blows"))
+	hash := ms.WorkingHash()
+	require.Equal(t, types.CommitID{}, ms.LastCommitID())
+
+	cID := ms.Commit()
+	require.Equal(t, hash, cID.Hash)
+	require.Equal(t, cID.Hash, ms.WorkingHash())
+
+	// uncommitted writes to a substore change the working hash but not the
+	// last commit
+	store2 := ms.getStoreByName("store2").(types.KVStore)
+	store2.Set([]byte("sun"), []byte("shines"))
+	hash = ms.WorkingHash()
+	require.NotEqual(t, cID.Hash, hash)
+	require.Equal(t, cID, ms.LastCommitID())
+
+	// transient stores are not part of the app hash
+	transient := ms.getStoreByName("transient").(types.KVStore)
+	transient.Set([]byte("fog"), []byte("lifts"))
+	require.Equal(t, hash, ms.WorkingHash())
+
+	cID = ms.Commit()
+	require.Equal(t, hash, cID.Hash)
+}
+
 func TestMultistoreCommitLoad(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db)
@@ -99,6 +99,10 @@ type CommitMultiStore interface {
 	// must be idempotent (return the same commit id). Otherwise the behavior is
 	// undefined.
 	LoadVersion(ver int64) error
+
+	// Returns the hash the next Commit would produce, computed from the
+	// uncommitted state of every store. Nothing is written to disk.
+	WorkingHash() []byte
 }
 
 //---------subsp-------------------------------